@@ -0,0 +1,113 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// RedirectStdLog routes the output of the standard library's log package
+// (log.Print, log.Fatal, ...) through l, so code that only knows about
+// log.Print still ends up in l's cores. Call the returned function to
+// restore the stdlib logger's previous output.
+func RedirectStdLog(l *Logger) func() {
+	return zap.RedirectStdLog(l.base)
+}
+
+// writer adapts a Logger to io.Writer for libraries that only accept one,
+// such as net/http.Server's ErrorLog or a database driver's logger hook.
+type writer struct {
+	l  *Logger
+	lv level
+}
+
+// Writer returns an io.Writer that logs each Write at lv, with trailing
+// newlines stripped.
+func (l *Logger) Writer(lv level) io.Writer {
+	return &writer{l: l, lv: lv}
+}
+
+func (w *writer) Write(p []byte) (int, error) {
+	msg := strings.TrimRight(string(p), "\n")
+
+	switch w.lv {
+	case Debug:
+		w.l.base.Debug(msg)
+	case Warn:
+		w.l.base.Warn(msg)
+	case Error:
+		w.l.base.Error(msg)
+	default:
+		w.l.base.Info(msg)
+	}
+
+	return len(p), nil
+}
+
+// SlogHandler adapts a *Logger to the slog.Handler interface (Go 1.21+),
+// so code written against log/slog - or any other framework that can be
+// pointed at a slog.Handler, such as a logrus hook shim - is routed
+// through l's cores instead of its own.
+type SlogHandler struct {
+	l *Logger
+}
+
+// NewSlogHandler returns a slog.Handler backed by l.
+func NewSlogHandler(l *Logger) *SlogHandler {
+	return &SlogHandler{l: l}
+}
+
+func (h *SlogHandler) Enabled(_ context.Context, lv slog.Level) bool {
+	return h.l.base.Core().Enabled(zapLevelFromSlog(lv))
+}
+
+func (h *SlogHandler) Handle(_ context.Context, rec slog.Record) error {
+	fields := make([]Field, 0, rec.NumAttrs())
+	rec.Attrs(func(a slog.Attr) bool {
+		fields = append(fields, Any(a.Key, a.Value.Any()))
+		return true
+	})
+
+	switch {
+	case rec.Level >= slog.LevelError:
+		h.l.base.Error(rec.Message, fields...)
+	case rec.Level >= slog.LevelWarn:
+		h.l.base.Warn(rec.Message, fields...)
+	case rec.Level < slog.LevelInfo:
+		h.l.base.Debug(rec.Message, fields...)
+	default:
+		h.l.base.Info(rec.Message, fields...)
+	}
+
+	return nil
+}
+
+func (h *SlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	fields := make([]Field, len(attrs))
+	for i, a := range attrs {
+		fields[i] = Any(a.Key, a.Value.Any())
+	}
+
+	return &SlogHandler{l: h.l.With(fields...)}
+}
+
+func (h *SlogHandler) WithGroup(name string) slog.Handler {
+	return &SlogHandler{l: h.l.Named(name)}
+}
+
+func zapLevelFromSlog(lv slog.Level) zapcore.Level {
+	switch {
+	case lv >= slog.LevelError:
+		return zapcore.ErrorLevel
+	case lv >= slog.LevelWarn:
+		return zapcore.WarnLevel
+	case lv < slog.LevelInfo:
+		return zapcore.DebugLevel
+	default:
+		return zapcore.InfoLevel
+	}
+}
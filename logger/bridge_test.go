@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+)
+
+func TestWriterLogsEachWriteAsOneEntry(t *testing.T) {
+	l, logs := newObservedLogger(Debug)
+
+	w := l.Writer(Warn)
+	if _, err := io.WriteString(w, "disk almost full\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+	if entries[0].Message != "disk almost full" {
+		t.Fatalf("message = %q, want the trailing newline stripped", entries[0].Message)
+	}
+	if entries[0].Level != zapLevel(Warn) {
+		t.Fatalf("level = %v, want %v", entries[0].Level, zapLevel(Warn))
+	}
+}
+
+func TestSlogHandlerRoutesThroughLogger(t *testing.T) {
+	l, logs := newObservedLogger(Debug)
+	handler := NewSlogHandler(l)
+	sl := slog.New(handler)
+
+	sl.With("request_id", "abc").Error("boom")
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+	if entries[0].Message != "boom" {
+		t.Fatalf("message = %q, want %q", entries[0].Message, "boom")
+	}
+	if got := entries[0].ContextMap()["request_id"]; got != "abc" {
+		t.Fatalf("request_id field = %v, want %q", got, "abc")
+	}
+}
+
+func TestSlogHandlerEnabled(t *testing.T) {
+	l, _ := newObservedLogger(Warn)
+	handler := NewSlogHandler(l)
+
+	if handler.Enabled(context.Background(), slog.LevelDebug) {
+		t.Fatal("Enabled(Debug) = true, want false when the Logger's floor is Warn")
+	}
+	if !handler.Enabled(context.Background(), slog.LevelError) {
+		t.Fatal("Enabled(Error) = false, want true")
+	}
+}
@@ -0,0 +1,119 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func writeTestConfig(t *testing.T, dir, name, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestLoadConfigJSON(t *testing.T) {
+	path := writeTestConfig(t, t.TempDir(), "config.json",
+		`{"app_name":"svc","level":"debug","enable_console":true}`)
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.AppName != "svc" || cfg.Level != "debug" || !cfg.EnableConsole {
+		t.Fatalf("unexpected config: %+v", cfg)
+	}
+}
+
+func TestLoadConfigYAML(t *testing.T) {
+	path := writeTestConfig(t, t.TempDir(), "config.yaml",
+		"app_name: svc\nlevel: warn\nenable_console: true\n")
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.AppName != "svc" || cfg.Level != "warn" {
+		t.Fatalf("unexpected config: %+v", cfg)
+	}
+}
+
+func TestLoadConfigTOML(t *testing.T) {
+	path := writeTestConfig(t, t.TempDir(), "config.toml",
+		"app_name = \"svc\"\nlevel = \"error\"\nenable_console = true\n")
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.AppName != "svc" || cfg.Level != "error" {
+		t.Fatalf("unexpected config: %+v", cfg)
+	}
+}
+
+func TestLoadConfigUnsupportedExtension(t *testing.T) {
+	path := writeTestConfig(t, t.TempDir(), "config.ini", "x")
+
+	if _, err := LoadConfig(path); err == nil {
+		t.Fatal("expected an error for an unsupported config extension")
+	}
+}
+
+func TestReloadConfigSwapsCoresInPlace(t *testing.T) {
+	l, err := NewFromConfig(Config{AppName: "reload-test", Level: "info", EnableConsole: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := l.root
+
+	path := writeTestConfig(t, t.TempDir(), "config.json",
+		`{"app_name":"reload-test","level":"error","enable_console":true}`)
+
+	if err := ReloadConfig(path, l); err != nil {
+		t.Fatal(err)
+	}
+
+	if l.root != root {
+		t.Fatal("ReloadConfig replaced l.root instead of swapping its cores in place")
+	}
+	if got := GetLevel("reload-test"); got != Error {
+		t.Fatalf("GetLevel() = %v, want Error", got)
+	}
+}
+
+// TestReloadConfigConcurrentWithLogging exercises the race ReloadConfig
+// used to have when it reassigned l.base/l.logger/l.root directly while
+// other goroutines read them to log. Run with -race to catch regressions.
+func TestReloadConfigConcurrentWithLogging(t *testing.T) {
+	l, err := NewFromConfig(Config{AppName: "reload-race-test", Level: "info", EnableConsole: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := writeTestConfig(t, t.TempDir(), "config.json",
+		`{"app_name":"reload-race-test","level":"info","enable_console":true}`)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			l.Info("hello")
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			if err := ReloadConfig(path, l); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,47 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// AddCore attaches core to l's root so it starts receiving every entry l
+// logs from now on, returning an id that can later be passed to RemoveCore.
+// It fails if l wasn't built with a dynamic root (for example, a Logger
+// returned by GetGlobal).
+func (l *Logger) AddCore(core zapcore.Core) (int, error) {
+	if l.root == nil {
+		return 0, fmt.Errorf("logger: Logger has no dynamic core to attach to")
+	}
+
+	return l.root.add(core), nil
+}
+
+// RemoveCore detaches the core previously returned by AddCore. Removing an
+// id that doesn't exist, or calling RemoveCore on a Logger with no dynamic
+// root, is a no-op.
+func (l *Logger) RemoveCore(id int) {
+	if l.root == nil {
+		return
+	}
+
+	l.root.remove(id)
+}
+
+// AddOutput is a convenience wrapper around AddCore that builds a core
+// writing to w at the given level, encoded as either "json" or "console".
+func (l *Logger) AddOutput(w io.Writer, lv level, encoding string) (int, error) {
+	var encoder zapcore.Encoder
+	switch encoding {
+	case "json":
+		encoder = zapcore.NewJSONEncoder(productionEncoderConfig())
+	default:
+		encoder = zapcore.NewConsoleEncoder(developmentEncoderConfig())
+	}
+
+	core := zapcore.NewCore(encoder, zapcore.AddSync(w), zapLevel(lv))
+
+	return l.AddCore(core)
+}
@@ -0,0 +1,28 @@
+package logger
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+// TestWithDetachesFromRoot guards the documented limitation on With/Named:
+// a core added to l's root after a child was derived from l is invisible
+// to that child, even though the child still points at the same root.
+func TestWithDetachesFromRoot(t *testing.T) {
+	root := newLockedMultiCore()
+	base := zap.New(root)
+	l := &Logger{base: base, logger: base.Sugar(), root: root}
+
+	child := l.With(String("request_id", "abc"))
+
+	extra := newCountingCore()
+	if _, err := child.AddCore(extra); err != nil {
+		t.Fatalf("AddCore() error = %v", err)
+	}
+
+	child.Info("hello")
+	if got := extra.Count(); got != 0 {
+		t.Fatalf("extra core Count() = %d, want 0 - child.With should have detached from the live root", got)
+	}
+}
@@ -0,0 +1,38 @@
+// Package crash captures Go runtime crashes - panics, segfaults and other
+// failures that never pass through zap - by redirecting the process's
+// stderr to a rotating file, giving operators a post-mortem without an
+// external supervisor watching the process's output.
+package crash
+
+import (
+	"io"
+	"os"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// CrashLog redirects the process's stderr to a rotating file at path. It
+// does this by pointing the OS-level stderr handle at a pipe and copying
+// everything written to that pipe into a lumberjack.Logger, so rotation
+// (size-based by default) keeps working even though stderr itself can't be
+// reopened mid-process.
+func CrashLog(path string) error {
+	out := &lumberjack.Logger{
+		Filename: path,
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+
+	if err := redirectStderr(w); err != nil {
+		r.Close()
+		w.Close()
+		return err
+	}
+
+	go io.Copy(out, r)
+
+	return nil
+}
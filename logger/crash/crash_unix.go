@@ -0,0 +1,13 @@
+//go:build unix && !(linux && (arm64 || riscv64 || loong64))
+
+package crash
+
+import (
+	"os"
+	"syscall"
+)
+
+// redirectStderr dup2's w onto the process's stderr file descriptor.
+func redirectStderr(w *os.File) error {
+	return syscall.Dup2(int(w.Fd()), int(os.Stderr.Fd()))
+}
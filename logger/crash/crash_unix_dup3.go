@@ -0,0 +1,15 @@
+//go:build linux && (arm64 || riscv64 || loong64)
+
+package crash
+
+import (
+	"os"
+	"syscall"
+)
+
+// redirectStderr dup3's w onto the process's stderr file descriptor.
+// These linux arches have no Dup2 syscall, so Dup3 (with no flags) stands
+// in for it.
+func redirectStderr(w *os.File) error {
+	return syscall.Dup3(int(w.Fd()), int(os.Stderr.Fd()), 0)
+}
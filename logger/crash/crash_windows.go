@@ -0,0 +1,13 @@
+//go:build windows
+
+package crash
+
+import (
+	"os"
+	"syscall"
+)
+
+// redirectStderr points the process's standard error handle at w.
+func redirectStderr(w *os.File) error {
+	return syscall.SetStdHandle(syscall.STD_ERROR_HANDLE, syscall.Handle(w.Fd()))
+}
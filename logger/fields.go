@@ -0,0 +1,45 @@
+package logger
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Field is a strongly typed key/value pair attached to a structured log
+// entry. It aliases zap.Field so callers never need to import zap
+// themselves; build one with the constructors below.
+type Field = zap.Field
+
+// String constructs a Field holding a string value.
+func String(key, val string) Field {
+	return zap.String(key, val)
+}
+
+// Int constructs a Field holding an int value.
+func Int(key string, val int) Field {
+	return zap.Int(key, val)
+}
+
+// Err constructs a Field holding an error under the conventional "error" key.
+func Err(err error) Field {
+	return zap.Error(err)
+}
+
+// Duration constructs a Field holding a time.Duration value.
+func Duration(key string, val time.Duration) Field {
+	return zap.Duration(key, val)
+}
+
+// Any constructs a Field from an arbitrary value, choosing the most
+// specific zap constructor it can via reflection. Prefer a typed
+// constructor above when one exists.
+func Any(key string, val interface{}) Field {
+	return zap.Any(key, val)
+}
+
+// Stack constructs a Field holding the current goroutine's stacktrace under
+// the given key.
+func Stack(key string) Field {
+	return zap.Stack(key)
+}
@@ -0,0 +1,62 @@
+package logger
+
+import (
+	"net/http"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+var (
+	subsystemsMu sync.Mutex
+	subsystems   = make(map[string]*zap.AtomicLevel)
+)
+
+// GetSubsystem returns the AtomicLevel controlling the named subsystem's
+// verbosity, creating one at Default level the first time it's requested.
+// Subsystems are keyed by the name passed to New/NewFromConfig, so changes
+// made through SetLevel or the handler returned by LevelHandler take effect
+// immediately on that logger's cores.
+func GetSubsystem(name string) *zap.AtomicLevel {
+	subsystemsMu.Lock()
+	defer subsystemsMu.Unlock()
+
+	if lv, ok := subsystems[name]; ok {
+		return lv
+	}
+
+	lv := zap.NewAtomicLevelAt(zapLevel(Default))
+	subsystems[name] = &lv
+	return &lv
+}
+
+// SetLevel sets the minimum level logged by the named subsystem.
+func SetLevel(subsystem string, lv level) {
+	GetSubsystem(subsystem).SetLevel(zapLevel(lv))
+}
+
+// GetLevel returns the minimum level currently logged by the named subsystem.
+func GetLevel(subsystem string) level {
+	return levelFromZap(GetSubsystem(subsystem).Level())
+}
+
+// LevelHandler returns an http.Handler that lets operators GET the current
+// level or PUT a new one for the named subsystem, mirroring zap.AtomicLevel's
+// own ServeHTTP.
+func LevelHandler(subsystem string) http.Handler {
+	return GetSubsystem(subsystem)
+}
+
+func levelFromZap(lv zapcore.Level) level {
+	switch lv {
+	case zapcore.DebugLevel:
+		return Debug
+	case zapcore.WarnLevel:
+		return Warn
+	case zapcore.ErrorLevel:
+		return Error
+	default:
+		return Info
+	}
+}
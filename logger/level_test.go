@@ -0,0 +1,63 @@
+package logger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetSubsystemReturnsSameInstance(t *testing.T) {
+	name := "test-subsystem-same-instance"
+
+	a := GetSubsystem(name)
+	b := GetSubsystem(name)
+	if a != b {
+		t.Fatalf("GetSubsystem(%q) returned different instances", name)
+	}
+}
+
+func TestSetLevelGetLevel(t *testing.T) {
+	name := "test-subsystem-set-get"
+
+	SetLevel(name, Debug)
+	if got := GetLevel(name); got != Debug {
+		t.Fatalf("GetLevel() = %v, want Debug", got)
+	}
+
+	SetLevel(name, Error)
+	if got := GetLevel(name); got != Error {
+		t.Fatalf("GetLevel() = %v, want Error", got)
+	}
+}
+
+func TestLevelHandlerGetReportsCurrentLevel(t *testing.T) {
+	name := "test-subsystem-handler-get"
+	SetLevel(name, Warn)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	LevelHandler(name).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if !strings.Contains(rec.Body.String(), "warn") {
+		t.Fatalf("body = %q, want it to report warn", rec.Body.String())
+	}
+}
+
+func TestLevelHandlerPutChangesLevel(t *testing.T) {
+	name := "test-subsystem-handler-put"
+
+	req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(`{"level":"error"}`))
+	rec := httptest.NewRecorder()
+	LevelHandler(name).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if got := GetLevel(name); got != Error {
+		t.Fatalf("GetLevel() = %v, want Error", got)
+	}
+}
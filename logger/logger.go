@@ -6,6 +6,8 @@ import (
 
 type Logger struct {
 	logger *zap.SugaredLogger
+	base   *zap.Logger
+	root   *lockedMultiCore
 }
 
 func (l *Logger) Panic(args ...interface{}) {
@@ -32,6 +34,12 @@ func (l *Logger) Debugln(args ...interface{}) {
 	l.logger.Debugln(args...)
 }
 
+// Debugw is the structured counterpart to Debug: it logs msg with fields
+// attached, without going through sugared reflection-based formatting.
+func (l *Logger) Debugw(msg string, fields ...Field) {
+	l.base.Debug(msg, fields...)
+}
+
 func (l *Logger) Error(args ...interface{}) {
 	l.logger.Error(args...)
 }
@@ -44,6 +52,12 @@ func (l *Logger) Errorln(args ...interface{}) {
 	l.logger.Errorln(args...)
 }
 
+// Errorw is the structured counterpart to Error: it logs msg with fields
+// attached, without going through sugared reflection-based formatting.
+func (l *Logger) Errorw(msg string, fields ...Field) {
+	l.base.Error(msg, fields...)
+}
+
 func (l *Logger) Fatal(args ...interface{}) {
 	l.logger.Fatal(args...)
 }
@@ -56,6 +70,13 @@ func (l *Logger) Fatalln(args ...interface{}) {
 	l.logger.Fatalln(args...)
 }
 
+// Fatalw is the structured counterpart to Fatal: it logs msg with fields
+// attached, without going through sugared reflection-based formatting,
+// then calls os.Exit(1).
+func (l *Logger) Fatalw(msg string, fields ...Field) {
+	l.base.Fatal(msg, fields...)
+}
+
 func (l *Logger) Info(args ...interface{}) {
 	l.logger.Info(args...)
 }
@@ -68,6 +89,12 @@ func (l *Logger) Infoln(args ...interface{}) {
 	l.logger.Infoln(args...)
 }
 
+// Infow is the structured counterpart to Info: it logs msg with fields
+// attached, without going through sugared reflection-based formatting.
+func (l *Logger) Infow(msg string, fields ...Field) {
+	l.base.Info(msg, fields...)
+}
+
 func (l *Logger) Warn(args ...interface{}) {
 	l.logger.Warn(args...)
 }
@@ -79,3 +106,42 @@ func (l *Logger) Warnf(template string, args ...interface{}) {
 func (l *Logger) Warnln(args ...interface{}) {
 	l.logger.Warnln(args...)
 }
+
+// Warnw is the structured counterpart to Warn: it logs msg with fields
+// attached, without going through sugared reflection-based formatting.
+func (l *Logger) Warnw(msg string, fields ...Field) {
+	l.base.Warn(msg, fields...)
+}
+
+// With returns a child Logger that appends the given fields to every
+// subsequent log entry, sugared or structured. It's the mechanism for
+// propagating context such as request IDs or trace IDs down a call chain.
+// The child keeps l's root field, but zapcore.Core.With hands back a core
+// that's already baked the new fields in, detached from l.root's live set
+// of cores; AddCore/RemoveCore called on l continue to affect l and any
+// other Logger built from l.root directly, but they have no effect on the
+// child returned here or on loggers derived from it.
+func (l *Logger) With(fields ...Field) *Logger {
+	args := make([]interface{}, len(fields))
+	for i, f := range fields {
+		args[i] = f
+	}
+
+	return &Logger{
+		logger: l.logger.With(args...),
+		base:   l.base.With(fields...),
+		root:   l.root,
+	}
+}
+
+// Named returns a child Logger with name appended to the current logger's
+// name, joined by a dot. As with With, the child's core is detached from
+// l.root's live set: AddCore/RemoveCore called on l afterwards don't reach
+// the returned Logger.
+func (l *Logger) Named(name string) *Logger {
+	return &Logger{
+		logger: l.logger.Named(name),
+		base:   l.base.Named(name),
+		root:   l.root,
+	}
+}
@@ -0,0 +1,99 @@
+package logger
+
+import (
+	"sync"
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// countingCore is a minimal zapcore.Core that counts writes, for asserting
+// which cores a lockedMultiCore actually fans out to.
+type countingCore struct {
+	zapcore.LevelEnabler
+	mu    sync.Mutex
+	count int
+}
+
+func newCountingCore() *countingCore {
+	return &countingCore{LevelEnabler: zapcore.DebugLevel}
+}
+
+func (c *countingCore) With(_ []zapcore.Field) zapcore.Core { return c }
+
+func (c *countingCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return ce.AddCore(ent, c)
+}
+
+func (c *countingCore) Write(zapcore.Entry, []zapcore.Field) error {
+	c.mu.Lock()
+	c.count++
+	c.mu.Unlock()
+
+	return nil
+}
+
+func (c *countingCore) Sync() error { return nil }
+
+func (c *countingCore) Count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.count
+}
+
+func TestLockedMultiCoreAddRemove(t *testing.T) {
+	m := newLockedMultiCore()
+	core := newCountingCore()
+
+	id := m.add(core)
+	m.Write(zapcore.Entry{}, nil)
+	if got := core.Count(); got != 1 {
+		t.Fatalf("Count() after add = %d, want 1", got)
+	}
+
+	m.remove(id)
+	m.Write(zapcore.Entry{}, nil)
+	if got := core.Count(); got != 1 {
+		t.Fatalf("Count() after remove = %d, want still 1", got)
+	}
+}
+
+func TestLockedMultiCoreSwapReplacesAllCores(t *testing.T) {
+	original := newCountingCore()
+	m := newLockedMultiCore(original)
+
+	replacement := newCountingCore()
+	m.swap(replacement)
+
+	m.Write(zapcore.Entry{}, nil)
+	if got := original.Count(); got != 0 {
+		t.Fatalf("original core Count() = %d, want 0 after swap", got)
+	}
+	if got := replacement.Count(); got != 1 {
+		t.Fatalf("replacement core Count() = %d, want 1", got)
+	}
+}
+
+func TestLockedMultiCoreConcurrentAddRemoveWrite(t *testing.T) {
+	m := newLockedMultiCore()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			id := m.add(newCountingCore())
+			m.remove(id)
+		}()
+		go func() {
+			defer wg.Done()
+			m.Write(zapcore.Entry{}, nil)
+		}()
+		go func() {
+			defer wg.Done()
+			m.swap(newCountingCore())
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,15 @@
+package logger
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// newObservedLogger builds a Logger backed by an observer.ObservedLogs
+// core, so tests can assert on what was logged without touching stdout.
+func newObservedLogger(lv level) (*Logger, *observer.ObservedLogs) {
+	core, logs := observer.New(zapLevel(lv))
+	base := zap.New(withSpanEvents(core))
+
+	return &Logger{logger: base.Sugar(), base: base}, logs
+}
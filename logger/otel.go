@@ -0,0 +1,131 @@
+package logger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap/zapcore"
+)
+
+type ctxKey struct{}
+
+// WithContext returns a copy of ctx carrying l, for later retrieval with
+// FromContext.
+func WithContext(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, l)
+}
+
+// FromContext returns the Logger previously attached with WithContext, or
+// the global Logger if ctx carries none.
+func FromContext(ctx context.Context) *Logger {
+	if l, ok := ctx.Value(ctxKey{}).(*Logger); ok {
+		return l
+	}
+
+	return GetGlobal()
+}
+
+// spanFieldKey is the field name used to smuggle the active span through
+// zapcore.Core.With into spanEventCore.Write. It's never forwarded to the
+// wrapped core, so it can't leak into encoded log output.
+const spanFieldKey = "logger.internal.span"
+
+// Ctx returns a child Logger with trace_id and span_id fields pre-attached
+// from the OpenTelemetry span active in ctx. If ctx carries no valid span,
+// it returns l unchanged. Every Logger's core chain is wrapped with
+// spanEventCore at construction time (see New, NewFromConfig and GetGlobal), so any
+// entry at Error level or above logged through the result - sugared or
+// structured - is additionally recorded as an event on the span, mirroring
+// otelzap's approach to trace correlation.
+func (l *Logger) Ctx(ctx context.Context) *Logger {
+	span := trace.SpanFromContext(ctx)
+	sc := span.SpanContext()
+	if !sc.IsValid() {
+		return l
+	}
+
+	return l.With(
+		String("trace_id", sc.TraceID().String()),
+		String("span_id", sc.SpanID().String()),
+		Any(spanFieldKey, span),
+	)
+}
+
+// spanEventCore wraps a zapcore.Core, recording Error-level-and-above
+// entries as events on a span smuggled through via the spanFieldKey field
+// set by Ctx. The field is stripped before being forwarded to the wrapped
+// core or any encoder, so it never leaks into log output, and because it's
+// installed once at construction it fires for every logging path - sugared
+// and structured alike - rather than only the methods that remember to call
+// it explicitly.
+type spanEventCore struct {
+	zapcore.Core
+	span trace.Span
+}
+
+// withSpanEvents wraps core with span-event recording. It's installed via
+// zap.WrapCore so it applies uniformly regardless of how the Logger is
+// constructed.
+func withSpanEvents(core zapcore.Core) zapcore.Core {
+	return &spanEventCore{Core: core}
+}
+
+func (c *spanEventCore) With(fields []zapcore.Field) zapcore.Core {
+	kept, span := extractSpan(fields)
+	if span == nil {
+		span = c.span
+	}
+
+	return &spanEventCore{Core: c.Core.With(kept), span: span}
+}
+
+func (c *spanEventCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+
+	return ce
+}
+
+func (c *spanEventCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	kept, span := extractSpan(fields)
+	if span == nil {
+		span = c.span
+	}
+
+	if span != nil && ent.Level >= zapcore.ErrorLevel && span.IsRecording() {
+		span.AddEvent(ent.Message)
+	}
+
+	// Check registered c rather than the wrapped core, so run the wrapped
+	// core's own Check here: that keeps each leaf core's level filter and
+	// any sampler in the chain in effect.
+	if ce := c.Core.Check(ent, nil); ce != nil {
+		ce.Write(kept...)
+	}
+
+	return nil
+}
+
+// extractSpan pulls the span smuggled under spanFieldKey out of fields, if
+// present, returning the remaining fields alongside it.
+func extractSpan(fields []zapcore.Field) ([]zapcore.Field, trace.Span) {
+	var span trace.Span
+	kept := fields
+	for i, f := range fields {
+		if f.Key != spanFieldKey {
+			continue
+		}
+
+		if s, ok := f.Interface.(trace.Span); ok {
+			span = s
+		}
+
+		kept = make([]zapcore.Field, 0, len(fields)-1)
+		kept = append(kept, fields[:i]...)
+		kept = append(kept, fields[i+1:]...)
+		break
+	}
+
+	return kept, span
+}
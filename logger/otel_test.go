@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func testSpanContext(t *testing.T) trace.SpanContext {
+	t.Helper()
+
+	var traceID trace.TraceID
+	copy(traceID[:], []byte("0123456789abcdef"))
+	var spanID trace.SpanID
+	copy(spanID[:], []byte("01234567"))
+
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	})
+}
+
+func TestCtxAttachesTraceFields(t *testing.T) {
+	l, logs := newObservedLogger(Debug)
+
+	sc := testSpanContext(t)
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	l.Ctx(ctx).Infow("hello")
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+
+	fields := entries[0].ContextMap()
+	if fields["trace_id"] != sc.TraceID().String() {
+		t.Fatalf("trace_id = %v, want %v", fields["trace_id"], sc.TraceID().String())
+	}
+	if fields["span_id"] != sc.SpanID().String() {
+		t.Fatalf("span_id = %v, want %v", fields["span_id"], sc.SpanID().String())
+	}
+}
+
+func TestCtxWithoutSpanReturnsSameLogger(t *testing.T) {
+	l, _ := newObservedLogger(Debug)
+
+	if got := l.Ctx(context.Background()); got != l {
+		t.Fatal("Ctx() with no span in context should return l unchanged")
+	}
+}
+
+// TestCtxSpanNeverEncoded guards against the span that Ctx passes down as an
+// internal field leaking into encoded output: spanEventCore must keep it and
+// strip it before the wrapped core sees it.
+func TestCtxSpanNeverEncoded(t *testing.T) {
+	l, logs := newObservedLogger(Debug)
+
+	sc := testSpanContext(t)
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	l.Ctx(ctx).Errorw("boom")
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+	for key := range entries[0].ContextMap() {
+		if key != "trace_id" && key != "span_id" {
+			t.Fatalf("unexpected encoded field %q - the span must not leak into output", key)
+		}
+	}
+}
+
+func TestWithContextFromContextRoundTrip(t *testing.T) {
+	l, _ := newObservedLogger(Info)
+
+	ctx := WithContext(context.Background(), l)
+	if got := FromContext(ctx); got != l {
+		t.Fatal("FromContext did not return the Logger attached by WithContext")
+	}
+
+	if got := FromContext(context.Background()); got == nil {
+		t.Fatal("FromContext with no attached Logger should fall back to the global Logger")
+	}
+}
@@ -0,0 +1,17 @@
+package logger
+
+// RecoverPanic runs fn and, if it panics, logs the panic value and the
+// current stacktrace at Error level before re-panicking. It never
+// suppresses the panic: unless a caller further up recovers, the process
+// still dies. logger/crash.CrashLog captures what the runtime then writes
+// to stderr; it does not keep the process alive.
+func (l *Logger) RecoverPanic(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			l.base.Error("panic recovered", Any("panic", r), Stack("stacktrace"))
+			panic(r)
+		}
+	}()
+
+	fn()
+}
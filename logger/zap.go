@@ -27,35 +27,44 @@ type (
 )
 
 func GetGlobal() *Logger {
+	base := zap.L().WithOptions(zap.WrapCore(withSpanEvents))
 	return &Logger{
-		logger: zap.L().Sugar(),
+		logger: base.Sugar(),
+		base:   base,
 	}
 }
 
 func New(name string, mode mode, minLevel level, filePaths ...string) (*Logger, error) {
-	var logger *zap.SugaredLogger
+	atomicLevel := GetSubsystem(name)
+	atomicLevel.SetLevel(zapLevel(minLevel))
+
+	var root *lockedMultiCore
 	var err error
 	switch mode {
 	case Production:
-		logger, err = newLogger(name, minLevel, productionEncoderConfig(), filePaths...)
+		root, err = newLogger(name, atomicLevel, productionEncoderConfig(), filePaths...)
 	default:
-		logger, err = newLogger(name, minLevel, developmentEncoderConfig(), filePaths...)
+		root, err = newLogger(name, atomicLevel, developmentEncoderConfig(), filePaths...)
 	}
 
 	if err != nil {
 		return nil, err
 	}
 
+	base := zap.New(root, zap.WrapCore(withSpanEvents)).Named(name)
+
 	return &Logger{
-		logger: logger,
+		logger: base.Sugar(),
+		base:   base,
+		root:   root,
 	}, nil
 }
 
 func ReplaceGlobals(logger *Logger) {
-	zap.ReplaceGlobals(logger.logger.Desugar())
+	zap.ReplaceGlobals(logger.base)
 }
 
-func newLogger(name string, minLevel level, cfg zapcore.EncoderConfig, paths ...string) (*zap.SugaredLogger, error) {
+func newLogger(name string, minLevel *zap.AtomicLevel, cfg zapcore.EncoderConfig, paths ...string) (*lockedMultiCore, error) {
 	var cores []zapcore.Core
 
 	cores = append(cores, consoleCore(minLevel, cfg))
@@ -68,17 +77,13 @@ func newLogger(name string, minLevel level, cfg zapcore.EncoderConfig, paths ...
 		cores = append(cores, fileCore)
 	}
 
-	logger := zap.New(zapcore.NewTee(cores...))
-
-	slogger := logger.Named(name).Sugar()
-
-	return slogger, nil
+	return newLockedMultiCore(cores...), nil
 }
 
-func pathCore(level level, encCfg zapcore.EncoderConfig, paths ...string) (zapcore.Core, error) {
+func pathCore(minLevel *zap.AtomicLevel, encCfg zapcore.EncoderConfig, paths ...string) (zapcore.Core, error) {
 	encoder := zapcore.NewJSONEncoder(encCfg)
 
-	files := make([]zapcore.WriteSyncer, len(paths))
+	files := make([]zapcore.WriteSyncer, 0, len(paths))
 	for _, path := range paths {
 		files = append(files, zapcore.AddSync(&lumberjack.Logger{
 			Filename: path,
@@ -88,10 +93,10 @@ func pathCore(level level, encCfg zapcore.EncoderConfig, paths ...string) (zapco
 
 	writer := zapcore.NewMultiWriteSyncer(files...)
 
-	return zapcore.NewCore(encoder, writer, zapLevel(level)), nil
+	return zapcore.NewCore(encoder, writer, minLevel), nil
 }
 
-func consoleCore(minLevel level, encCfg zapcore.EncoderConfig) zapcore.Core {
+func consoleCore(minLevel *zap.AtomicLevel, encCfg zapcore.EncoderConfig) zapcore.Core {
 	encoder := zapcore.NewConsoleEncoder(encCfg)
 	outputCore := zapcore.NewCore(encoder, os.Stdout, infoPriority(minLevel))
 	errCore := zapcore.NewCore(encoder, os.Stderr, errorPriority(minLevel))
@@ -147,17 +152,15 @@ func productionEncoderConfig() zapcore.EncoderConfig {
 	return cfg
 }
 
-func infoPriority(minLevel level) zap.LevelEnablerFunc {
-	minLV := zapLevel(minLevel)
+func infoPriority(minLevel *zap.AtomicLevel) zap.LevelEnablerFunc {
 	return func(lv zapcore.Level) bool {
-		return lv >= minLV && lv < zap.ErrorLevel
+		return lv >= minLevel.Level() && lv < zap.ErrorLevel
 	}
 }
 
-func errorPriority(minLevel level) zap.LevelEnablerFunc {
-	minLV := zapLevel(minLevel)
+func errorPriority(minLevel *zap.AtomicLevel) zap.LevelEnablerFunc {
 	return func(lv zapcore.Level) bool {
-		return lv >= minLV && lv >= zap.ErrorLevel
+		return lv >= minLevel.Level() && lv >= zap.ErrorLevel
 	}
 }
 
@@ -165,6 +168,8 @@ func zapLevel(level level) zapcore.Level {
 	switch level {
 	case Debug:
 		return zap.DebugLevel
+	case Warn:
+		return zap.WarnLevel
 	case Error:
 		return zap.ErrorLevel
 	default: